@@ -3,9 +3,11 @@ package clogg
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -25,6 +27,31 @@ type log struct {
 
 	// Attrs contains additional structured attributes or metadata associated with the log message.
 	Attrs []slog.Attr
+
+	// Ctx is the context the call site logged with. It is carried through to the handler,
+	// which lets context-aware handlers (e.g. OTel slog bridges) see the original context.
+	Ctx context.Context
+}
+
+// contextKey is unexported to keep clogg's context key from colliding with other packages'.
+type contextKey struct{}
+
+// loggerContextKey is the context.Value key under which NewContext stores a *Logger.
+var loggerContextKey = contextKey{}
+
+// NewContext returns a copy of ctx carrying l, retrievable with FromContext. A request-scoped
+// child Logger built with With or WithGroup typically rides on the context this way.
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey, l)
+}
+
+// FromContext returns the Logger previously stored in ctx by NewContext, or the singleton
+// logger if ctx carries none.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(loggerContextKey).(*Logger); ok {
+		return l
+	}
+	return globalLogger
 }
 
 // Logger is an asynchronous logger that processes log messages in a separate goroutine.
@@ -33,46 +60,481 @@ type Logger struct {
 	// logChan is a buffered channel used to enqueue log messages for asynchronous processing.
 	logChan chan log
 
-	// logger is the underlying slog.Logger used to format and output log messages.
-	logger *slog.Logger
+	// sinks are the fan-out destinations a record is dispatched to. Each runs its own
+	// bounded inbox and goroutine, isolating a slow sink from the others.
+	sinks []*sinkWorker
 
 	// done is a channel used to signal when the log processing goroutine has finished.
 	done chan struct{}
+
+	// mode controls what happens when logChan is full.
+	mode Mode
+
+	// retryAttempts is the number of send attempts ModeNonBlocking makes before dropping.
+	retryAttempts int
+
+	// retryBackoff is the delay between retries in ModeNonBlocking.
+	retryBackoff time.Duration
+
+	// enqueued, dropped and blocked track overflow-policy outcomes for Stats. Pointers,
+	// shared by child loggers created via With/WithGroup rather than copied per child.
+	enqueued *atomic.Uint64
+	dropped  *atomic.Uint64
+	blocked  *atomic.Uint64
+
+	// level is the minimum level admitted onto logChan, adjustable at runtime via SetLevel.
+	level *slog.LevelVar
+
+	// root is the Logger that owns logChan and done: itself for a root Logger, the
+	// originating Logger for one returned by With/WithGroup.
+	root *Logger
+
+	// ops records the chain of With/WithGroup calls that produced this Logger, applied to
+	// every record's attrs (innermost first) before it is enqueued.
+	ops []loggerOp
+
+	// extractor, if set, pulls attrs (trace ID, request ID, tenant, ...) out of the call's
+	// context before the record is enqueued.
+	extractor ContextExtractor
+
+	// sampler, if set, is consulted in logWithLevel to cap high-frequency log spam before
+	// it reaches logChan.
+	sampler Sampler
+
+	// sampleStats tracks, per level, how many records the sampler admitted vs suppressed.
+	// Maps slog.Level to *sampleCounters; a pointer so child loggers share it without
+	// copying the underlying sync.Map.
+	sampleStats *sync.Map
+}
+
+// ContextExtractor pulls structured attributes out of a context.Context, e.g. trace/span
+// IDs, request IDs, or a tenant set by request-scoped middleware.
+type ContextExtractor func(context.Context) []Attr
+
+// SampleDecision is the result of a Sampler's admission check for one record.
+type SampleDecision struct {
+	// Admit reports whether the record should be enqueued.
+	Admit bool
+
+	// SampledCount, if non-zero, is attached to an admitted record as a "sampled_count"
+	// attr reporting how many prior occurrences of the same (level, msg) key were
+	// suppressed since the last admitted one.
+	SampledCount uint64
+}
+
+// Sampler decides whether a (level, msg) record should be admitted onto logChan, capping
+// spam from a hot loop before it ever reaches the buffer.
+type Sampler interface {
+	Sample(level slog.Level, msg string) SampleDecision
+}
+
+// sampleCounters are the per-level sampled-in/sampled-out counts backing SamplerStats.
+type sampleCounters struct {
+	in  atomic.Uint64
+	out atomic.Uint64
+}
+
+// recordSample updates the sampled-in/sampled-out counters for level.
+func (l *Logger) recordSample(level slog.Level, admitted bool) {
+	v, _ := l.sampleStats.LoadOrStore(level, &sampleCounters{})
+	counters := v.(*sampleCounters)
+	if admitted {
+		counters.in.Add(1)
+	} else {
+		counters.out.Add(1)
+	}
+}
+
+// SamplerStats reports, per level, how many records the configured Sampler admitted versus
+// suppressed. It is empty if no Sampler is configured.
+type SamplerStats struct {
+	In  uint64
+	Out uint64
+}
+
+// SamplerStats returns a snapshot of sampled-in/sampled-out counts by level.
+func (l *Logger) SamplerStats() map[slog.Level]SamplerStats {
+	stats := make(map[slog.Level]SamplerStats)
+	l.sampleStats.Range(func(k, v any) bool {
+		counters := v.(*sampleCounters)
+		stats[k.(slog.Level)] = SamplerStats{In: counters.in.Load(), Out: counters.out.Load()}
+		return true
+	})
+	return stats
+}
+
+// sampleShards and sampleBucketSize bound a sampleTable to sampleShards*sampleBucketSize
+// entries (1024) total, keeping per-key sampler state bounded under adversarial key
+// cardinality. A key that hashes into an already-occupied slot evicts the old entry, which
+// just starts over as "log next occurrence" the next time its message is seen.
+const (
+	sampleShards     = 16
+	sampleBucketSize = 64
+)
+
+// sampleKey hashes a (level, msg) pair with FNV-64 for use as a sampleTable key.
+func sampleKey(level slog.Level, msg string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{byte(level), byte(level >> 8)})
+	h.Write([]byte(msg))
+	return h.Sum64()
+}
+
+// sampleShard is one fixed-size, direct-mapped bucket of per-key entries guarded by a
+// single mutex.
+type sampleShard[T any] struct {
+	mu      sync.Mutex
+	keys    [sampleBucketSize]uint64
+	entries [sampleBucketSize]T
+}
+
+// sampleTable is a sharded, fixed-capacity map from a sampleKey to per-key sampler state.
+type sampleTable[T any] struct {
+	shards [sampleShards]sampleShard[T]
+}
+
+// withEntry locks the shard owning key, resets the slot to its zero value if it currently
+// belongs to a different key (an eviction), and invokes fn with the slot's entry and
+// whether it was just reset.
+func (t *sampleTable[T]) withEntry(key uint64, fn func(entry *T, reset bool)) {
+	shard := &t.shards[key%sampleShards]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	idx := (key / sampleShards) % sampleBucketSize
+	reset := shard.keys[idx] != key
+	if reset {
+		shard.keys[idx] = key
+		var zero T
+		shard.entries[idx] = zero
+	}
+	fn(&shard.entries[idx], reset)
+}
+
+// Rate configures one level's tier in a TokenBucketSampler: Burst tokens are available up
+// front, refilling one token every Interval.
+type Rate struct {
+	Burst    int
+	Interval time.Duration
+}
+
+// tokenBucketEntry is the per-(level, msg) state for a TokenBucketSampler.
+type tokenBucketEntry struct {
+	tokens float64
+	last   time.Time
+	missed uint64
+}
+
+// TokenBucketSampler admits up to Tier[level].Burst records immediately and then one every
+// Tier[level].Interval, per distinct (level, msg) key. Levels absent from Tier, or whose
+// Rate has no Interval set, are admitted unconditionally. Use a pointer (e.g.
+// &TokenBucketSampler{...}) since it carries internal per-key state.
+type TokenBucketSampler struct {
+	Tier  map[slog.Level]Rate
+	table sampleTable[tokenBucketEntry]
+}
+
+// Sample implements Sampler.
+func (s *TokenBucketSampler) Sample(level slog.Level, msg string) SampleDecision {
+	rate, ok := s.Tier[level]
+	if !ok || rate.Interval <= 0 {
+		return SampleDecision{Admit: true}
+	}
+
+	now := time.Now()
+	var decision SampleDecision
+	s.table.withEntry(sampleKey(level, msg), func(e *tokenBucketEntry, reset bool) {
+		if reset {
+			e.tokens = float64(rate.Burst)
+			e.last = now
+		} else {
+			e.tokens += float64(now.Sub(e.last)) / float64(rate.Interval)
+			if e.tokens > float64(rate.Burst) {
+				e.tokens = float64(rate.Burst)
+			}
+			e.last = now
+		}
+
+		if e.tokens >= 1 {
+			e.tokens--
+			decision = SampleDecision{Admit: true, SampledCount: e.missed}
+			e.missed = 0
+		} else {
+			e.missed++
+			decision = SampleDecision{Admit: false}
+		}
+	})
+	return decision
+}
+
+// firstNEveryMEntry is the per-(level, msg) state for a FirstNThenEveryM sampler.
+type firstNEveryMEntry struct {
+	count       uint64
+	windowStart time.Time
+	missed      uint64
+}
+
+// FirstNThenEveryM admits the first N occurrences of a (level, msg) key, then one in every
+// M thereafter, resetting the counter every Interval. Use a pointer (e.g.
+// &FirstNThenEveryM{...}) since it carries internal per-key state.
+type FirstNThenEveryM struct {
+	N        uint64
+	M        uint64
+	Interval time.Duration
+
+	table sampleTable[firstNEveryMEntry]
+}
+
+// Sample implements Sampler.
+func (s *FirstNThenEveryM) Sample(level slog.Level, msg string) SampleDecision {
+	now := time.Now()
+	var decision SampleDecision
+	s.table.withEntry(sampleKey(level, msg), func(e *firstNEveryMEntry, reset bool) {
+		if reset || (s.Interval > 0 && now.Sub(e.windowStart) >= s.Interval) {
+			*e = firstNEveryMEntry{windowStart: now, missed: e.missed}
+		}
+		e.count++
+
+		switch {
+		case e.count <= s.N:
+			decision = SampleDecision{Admit: true, SampledCount: e.missed}
+			e.missed = 0
+		case s.M > 0 && e.count%s.M == 0:
+			decision = SampleDecision{Admit: true, SampledCount: e.missed}
+			e.missed = 0
+		default:
+			e.missed++
+			decision = SampleDecision{Admit: false}
+		}
+	})
+	return decision
+}
+
+// loggerOp is one link in a Logger's With/WithGroup chain.
+type loggerOp struct {
+	// attrs is set for a With call; group is set for a WithGroup call. Exactly one of the
+	// two is populated.
+	attrs []slog.Attr
+	group string
+}
+
+// Mode controls how the Logger behaves when logChan is full.
+type Mode int
+
+const (
+	// ModeNonBlocking retries a bounded number of times with a backoff between attempts,
+	// then drops the message. This is the default.
+	ModeNonBlocking Mode = iota
+
+	// ModeBlocking sends with no default case: the caller blocks until there is room.
+	ModeBlocking
+
+	// ModeDropNewest makes a single non-blocking send attempt and drops the incoming
+	// message on a miss, leaving the channel's existing contents untouched.
+	ModeDropNewest
+
+	// ModeDropOldest makes a single non-blocking send attempt and, on a miss, drains the
+	// oldest queued message and retries once so the most recent log survives.
+	ModeDropOldest
+)
+
+// defaultRetryAttempts and defaultRetryBackoff tune ModeNonBlocking when left unset.
+const (
+	defaultRetryAttempts = 3
+	defaultRetryBackoff  = 10 * time.Millisecond
+)
+
+// Stats reports overflow-policy counters for a Logger, suitable for exposing to monitoring.
+type Stats struct {
+	Enqueued uint64
+	Dropped  uint64
+	Blocked  uint64
+}
+
+// Sink is one fan-out destination for log records. A record is dispatched to a Sink
+// only if its level is at or above MinLevel. Name identifies the sink in diagnostics.
+type Sink struct {
+	Handler  slog.Handler
+	MinLevel slog.Level
+	Name     string
+}
+
+// sinkWorker gives a Sink its own bounded inbox and goroutine. Formatting or writing to
+// one sink can then never block or starve the others.
+type sinkWorker struct {
+	Sink
+	logger  *slog.Logger
+	inbox   chan log
+	done    chan struct{}
+	dropped atomic.Uint64
+}
+
+// newSinkWorker starts the goroutine that drains inbox for a sink.
+func newSinkWorker(sink Sink, bufferSize int) *sinkWorker {
+	w := &sinkWorker{
+		Sink:   sink,
+		logger: slog.New(sink.Handler),
+		inbox:  make(chan log, bufferSize),
+		done:   make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// run drains inbox until it is closed, then emits a consolidated drop summary if needed.
+func (w *sinkWorker) run() {
+	defer close(w.done)
+	for msg := range w.inbox {
+		w.logger.LogAttrs(msg.Ctx, msg.Level, msg.Msg, msg.Attrs...)
+	}
+	if dropped := w.dropped.Load(); dropped > 0 {
+		w.logger.LogAttrs(context.Background(), slog.LevelWarn, "dropped messages due to full buffer", slog.String("sink", w.Name), slog.Uint64("dropped", dropped))
+	}
+}
+
+// dispatch delivers msg to the sink's inbox if its level admits it, dropping it without
+// blocking if the inbox is full.
+func (w *sinkWorker) dispatch(msg log) {
+	if msg.Level < w.MinLevel {
+		return
+	}
+	select {
+	case w.inbox <- msg:
+	default:
+		w.dropped.Add(1)
+	}
 }
 
 // LoggerConfig defines the configuration for the Logger.
 //
 // Fields:
-// - BufferSize: The size of the buffered channel used to enqueue log messages for asynchronous processing.
-// - Handler: The slog.Handler used to format and output log messages.
+// - BufferSize: The size of the buffered channel used to enqueue log messages for asynchronous processing,
+//   and of each sink's own inbox.
+// - Handler: A single slog.Handler used to format and output log messages. Ignored if Sinks is set;
+//   kept for backward compatibility and wrapped into a single default Sink.
+// - Sinks: The fan-out destinations a record is dispatched to, each with its own MinLevel.
+// - Mode: The overflow policy applied when logChan is full. Defaults to ModeNonBlocking.
+// - RetryAttempts: The number of send attempts ModeNonBlocking makes before dropping. Defaults to 3.
+// - RetryBackoff: The delay between retries in ModeNonBlocking. Defaults to 10ms.
+// - Level: The minimum level admitted onto logChan, checked before enqueue. Defaults to slog.LevelInfo.
+//   Adjustable at runtime via SetLevel.
+// - ContextExtractor: Optional hook invoked synchronously in logWithLevel to pull attrs out of
+//   a call's context.Context before the record is enqueued.
+// - Sampler: Optional hook invoked synchronously in logWithLevel, before ContextExtractor, to
+//   cap high-frequency log spam. Records it rejects never reach logChan.
 type LoggerConfig struct {
-	BufferSize int
-	Handler    slog.Handler
+	BufferSize       int
+	Handler          slog.Handler
+	Sinks            []Sink
+	Mode             Mode
+	RetryAttempts    int
+	RetryBackoff     time.Duration
+	Level            slog.Leveler
+	ContextExtractor ContextExtractor
+	Sampler          Sampler
 }
 
 // GetLogger returns the singleton instance of the Logger.
 // If the logger has not been initialized, it initializes it with the provided configuration.
 func GetLogger(config LoggerConfig) *Logger {
 	once.Do(func() {
-		if config.BufferSize == 0 || config.Handler == nil {
-			// Provide default configuration if none is provided
-			config = LoggerConfig{
-				BufferSize: 100,
-				Handler:    slog.NewJSONHandler(os.Stdout, nil),
-			}
-		}
-		slogger := slog.New(config.Handler)
+		globalLogger = newLogger(config)
+	})
+	return globalLogger
+}
 
-		clogger := &Logger{
-			logChan: make(chan log, config.BufferSize),
-			logger:  slogger,
-			done:    make(chan struct{}),
+// newLogger builds and starts a Logger from config, applying defaults for any zero-valued
+// fields. GetLogger uses this for the package singleton; tests that need an isolated Logger
+// instead of the shared singleton can call it directly.
+func newLogger(config LoggerConfig) *Logger {
+	if config.BufferSize == 0 {
+		config.BufferSize = 100
+	}
+	if len(config.Sinks) == 0 {
+		// Thin backward-compatible wrapper: a single Handler becomes a single Sink.
+		if config.Handler == nil {
+			config.Handler = slog.NewJSONHandler(os.Stdout, nil)
 		}
+		config.Sinks = []Sink{{Handler: config.Handler, MinLevel: slog.LevelDebug, Name: "default"}}
+	}
+	if config.RetryAttempts == 0 {
+		config.RetryAttempts = defaultRetryAttempts
+	}
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = defaultRetryBackoff
+	}
+	if config.Level == nil {
+		config.Level = slog.LevelInfo
+	}
 
-		go clogger.processLogs()
-		globalLogger = clogger
-	})
-	return globalLogger
+	sinks := make([]*sinkWorker, len(config.Sinks))
+	for i, sink := range config.Sinks {
+		sinks[i] = newSinkWorker(sink, config.BufferSize)
+	}
+
+	level := &slog.LevelVar{}
+	level.Set(config.Level.Level())
+
+	clogger := &Logger{
+		logChan:       make(chan log, config.BufferSize),
+		sinks:         sinks,
+		done:          make(chan struct{}),
+		mode:          config.Mode,
+		retryAttempts: config.RetryAttempts,
+		retryBackoff:  config.RetryBackoff,
+		enqueued:      &atomic.Uint64{},
+		dropped:       &atomic.Uint64{},
+		blocked:       &atomic.Uint64{},
+		level:         level,
+		extractor:     config.ContextExtractor,
+		sampler:       config.Sampler,
+		sampleStats:   &sync.Map{},
+	}
+	clogger.root = clogger
+
+	go clogger.processLogs()
+	return clogger
+}
+
+// emit dispatches an internal diagnostic record directly to the sinks, bypassing logChan.
+func (l *Logger) emit(level slog.Level, msg string, attrs ...slog.Attr) {
+	entry := log{Level: level, Msg: msg, Attrs: attrs, Ctx: context.Background()}
+	for _, sink := range l.sinks {
+		sink.dispatch(entry)
+	}
+}
+
+// Stats returns a snapshot of the logger's overflow-policy counters.
+func (l *Logger) Stats() Stats {
+	return Stats{
+		Enqueued: l.enqueued.Load(),
+		Dropped:  l.dropped.Load(),
+		Blocked:  l.blocked.Load(),
+	}
+}
+
+// SetLevel adjusts the minimum level admitted onto logChan at runtime.
+func (l *Logger) SetLevel(level slog.Level) {
+	l.level.Set(level)
+}
+
+// Level returns the logger's current minimum admitted level.
+func (l *Logger) Level() slog.Level {
+	return l.level.Level()
+}
+
+// SetLevel adjusts the minimum level admitted by the singleton logger at runtime.
+func SetLevel(level slog.Level) {
+	if globalLogger != nil {
+		globalLogger.SetLevel(level)
+	}
+}
+
+// Level returns the singleton logger's current minimum admitted level.
+func Level() slog.Level {
+	if globalLogger != nil {
+		return globalLogger.Level()
+	}
+	return slog.LevelInfo
 }
 
 // Shutdown gracefully shuts down the logger by ensuring that all pending log messages
@@ -86,95 +548,237 @@ func GetLogger(config LoggerConfig) *Logger {
 //
 // This ensures that no log messages are lost during shutdown and that the logger
 // shuts down cleanly.
+//
+// Shutdown on a child Logger returned by With or WithGroup is a no-op: only the root
+// owns logChan and done, so only the root may close them.
 func (l *Logger) Shutdown() {
+	if l.root != l {
+		return
+	}
 	if globalLogger != nil {
 		close(l.logChan)
 		<-l.done // wait until log processor finishes
 	}
 }
 
-func Debug(ctx context.Context, msg string, attrs ...Attr) {
+// With returns a child Logger that prepends attrs to every record it logs, leaving the
+// receiver unchanged. The child shares the receiver's logChan, done and counters, so only
+// the root Logger's Shutdown actually closes anything.
+//
+// Attribute evaluation happens in the caller's goroutine, not the async log processor, so
+// there is no risk of a mutable attribute value being read after it has changed.
+func (l *Logger) With(attrs ...Attr) *Logger {
+	child := *l
+	child.ops = appendOp(l.ops, loggerOp{attrs: attrs})
+	return &child
+}
+
+// WithGroup returns a child Logger that nests every attr added by later With calls (and by
+// the log call site itself) inside a group named name. See With for sharing and evaluation
+// semantics.
+func (l *Logger) WithGroup(name string) *Logger {
+	child := *l
+	child.ops = appendOp(l.ops, loggerOp{group: name})
+	return &child
+}
+
+// With returns a child of the singleton logger bound to attrs. See (*Logger).With.
+func With(attrs ...Attr) *Logger {
 	if globalLogger != nil {
-		globalLogger.debug(ctx, msg, attrs...)
+		return globalLogger.With(attrs...)
 	}
+	return nil
 }
 
-func Info(ctx context.Context, msg string, attrs ...Attr) {
+// WithGroup returns a child of the singleton logger bound to group name. See (*Logger).WithGroup.
+func WithGroup(name string) *Logger {
 	if globalLogger != nil {
-		globalLogger.info(ctx, msg, attrs...)
+		return globalLogger.WithGroup(name)
+	}
+	return nil
+}
+
+// appendOp returns a new slice with op appended, leaving ops (and any other Logger sharing
+// its backing array) untouched.
+func appendOp(ops []loggerOp, op loggerOp) []loggerOp {
+	newOps := make([]loggerOp, len(ops)+1)
+	copy(newOps, ops)
+	newOps[len(ops)] = op
+	return newOps
+}
+
+// buildAttrs applies l's With/WithGroup chain to callAttrs, innermost (nearest the call
+// site) first, producing the final Attrs slice to enqueue.
+func (l *Logger) buildAttrs(callAttrs []slog.Attr) []slog.Attr {
+	attrs := callAttrs
+	for i := len(l.ops) - 1; i >= 0; i-- {
+		op := l.ops[i]
+		if op.group != "" {
+			attrs = []slog.Attr{{Key: op.group, Value: slog.GroupValue(attrs...)}}
+			continue
+		}
+		combined := make([]slog.Attr, 0, len(op.attrs)+len(attrs))
+		combined = append(combined, op.attrs...)
+		combined = append(combined, attrs...)
+		attrs = combined
+	}
+	return attrs
+}
+
+func Debug(ctx context.Context, msg string, attrs ...Attr) {
+	if logger := FromContext(ctx); logger != nil {
+		logger.Debug(ctx, msg, attrs...)
+	}
+}
+
+func Info(ctx context.Context, msg string, attrs ...Attr) {
+	if logger := FromContext(ctx); logger != nil {
+		logger.Info(ctx, msg, attrs...)
 	}
 }
 
 func Warn(ctx context.Context, msg string, attrs ...Attr) {
-	if globalLogger != nil {
-		globalLogger.warn(ctx, msg, attrs...)
+	if logger := FromContext(ctx); logger != nil {
+		logger.Warn(ctx, msg, attrs...)
 	}
 }
 
 func Error(ctx context.Context, msg string, attrs ...Attr) {
-	if globalLogger != nil {
-		globalLogger.error(ctx, msg, attrs...)
+	if logger := FromContext(ctx); logger != nil {
+		logger.Error(ctx, msg, attrs...)
 	}
 }
 
 // processLogs is a goroutine that continuously processes log messages from the logChan channel.
-// It reads messages from the channel, formats them using the underlying slog.Logger, and outputs them.
-// When the logChan channel is closed, it processes any remaining messages and then closes the done channel
-// to signal that the logging process has completed.
+// It reads messages from the channel and dispatches each one to every sink whose MinLevel
+// admits it. When the logChan channel is closed, it drains all sinks before closing the
+// done channel to signal that the logging process has completed.
 func (l *Logger) processLogs() {
 	// Signal that the log processing is complete by closing the done channel.
 	defer close(l.done)
 	for msg := range l.logChan {
-		// Process each log message and output it using the slog.Logger.
-		l.logger.LogAttrs(context.Background(), msg.Level, msg.Msg, msg.Attrs...)
+		for _, sink := range l.sinks {
+			sink.dispatch(msg)
+		}
+	}
+	// Emit a single consolidated dropped-message count at flush time instead of a warning on
+	// every retry. (Each dropped message still gets its own "failed to log" record from
+	// logWithLevel.)
+	if dropped := l.dropped.Load(); dropped > 0 {
+		l.emit(slog.LevelWarn, "dropped messages due to full buffer", slog.Uint64("dropped", dropped))
+	}
+	for _, sink := range l.sinks {
+		close(sink.inbox)
+	}
+	for _, sink := range l.sinks {
+		<-sink.done
 	}
 }
 
-// log is a method that enqueues a log message with the specified level, message, and attributes.
+// logMsg enqueues a log message with the specified level, message, and attributes,
+// applying the Logger's configured overflow Mode when logChan is full.
 func (l *Logger) logMsg(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) error {
-	const maxRetries = 3
-	for i := 0; i < maxRetries; i++ {
+	entry := log{Level: level, Msg: msg, Attrs: attrs, Ctx: ctx}
+
+	switch l.mode {
+	case ModeBlocking:
+		l.logChan <- entry
+		l.enqueued.Add(1)
+		return nil
+
+	case ModeDropNewest:
 		select {
-		case l.logChan <- log{Level: level, Msg: msg, Attrs: attrs}:
-			// Enqueued successfully
+		case l.logChan <- entry:
+			l.enqueued.Add(1)
 			return nil
 		default:
-			// Log a warning on retry
-			l.logger.LogAttrs(ctx, slog.LevelWarn, "retrying log message due to full buffer", slog.Int("attempt", i+1))
-			time.Sleep(10 * time.Millisecond) // Fixed delay between retries
+			l.dropped.Add(1)
+			return fmt.Errorf("logging buffer channel full")
+		}
+
+	case ModeDropOldest:
+		select {
+		case l.logChan <- entry:
+			l.enqueued.Add(1)
+			return nil
+		default:
+			select {
+			case <-l.logChan:
+				l.dropped.Add(1)
+			default:
+			}
+			select {
+			case l.logChan <- entry:
+				l.enqueued.Add(1)
+				return nil
+			default:
+				l.dropped.Add(1)
+				return fmt.Errorf("logging buffer channel full")
+			}
 		}
-	}
 
-	// If retries are exhausted, return an error
-	return fmt.Errorf("logging buffer channel full")
+	default: // ModeNonBlocking
+		for i := 0; i < l.retryAttempts; i++ {
+			select {
+			case l.logChan <- entry:
+				l.enqueued.Add(1)
+				return nil
+			default:
+				l.blocked.Add(1)
+				time.Sleep(l.retryBackoff)
+			}
+		}
+		l.dropped.Add(1)
+		return fmt.Errorf("logging buffer channel full")
+	}
 }
 
-// logWithLevel is a helper method to log messages at a specific level.
+// logWithLevel is a helper method to log messages at a specific level. Records below the
+// logger's current Level are dropped here, before they ever reach logChan.
 func (l *Logger) logWithLevel(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if level < l.level.Level() {
+		return
+	}
+	if l.sampler != nil {
+		decision := l.sampler.Sample(level, msg)
+		l.recordSample(level, decision.Admit)
+		if !decision.Admit {
+			return
+		}
+		if decision.SampledCount > 0 {
+			attrs = append(attrs, slog.Uint64("sampled_count", decision.SampledCount))
+		}
+	}
+	if l.extractor != nil {
+		attrs = append(l.extractor(ctx), attrs...)
+	}
+	if len(l.ops) > 0 {
+		attrs = l.buildAttrs(attrs)
+	}
 	err := l.logMsg(ctx, level, msg, attrs...)
 	if err != nil {
 		// Log an error if the message could not be enqueued
-		l.logger.LogAttrs(ctx, slog.LevelError, "failed to log", slog.String("error", err.Error()))
+		l.emit(slog.LevelError, "failed to log", slog.String("error", err.Error()))
 	}
 }
 
-// Debug logs a debug message
-func (l *Logger) debug(ctx context.Context, msg string, attrs ...slog.Attr) {
+// Debug logs a debug message.
+func (l *Logger) Debug(ctx context.Context, msg string, attrs ...slog.Attr) {
 	l.logWithLevel(ctx, slog.LevelDebug, msg, attrs...)
 }
 
-// Info logs an info message
-func (l *Logger) info(ctx context.Context, msg string, attrs ...slog.Attr) {
+// Info logs an info message. See Debug.
+func (l *Logger) Info(ctx context.Context, msg string, attrs ...slog.Attr) {
 	l.logWithLevel(ctx, slog.LevelInfo, msg, attrs...)
 }
 
-// Warn logs a warning message
-func (l *Logger) warn(ctx context.Context, msg string, attrs ...slog.Attr) {
+// Warn logs a warning message. See Debug.
+func (l *Logger) Warn(ctx context.Context, msg string, attrs ...slog.Attr) {
 	l.logWithLevel(ctx, slog.LevelWarn, msg, attrs...)
 }
 
-// Error logs an error message
-func (l *Logger) error(ctx context.Context, msg string, attrs ...slog.Attr) {
+// Error logs an error message. See Debug.
+func (l *Logger) Error(ctx context.Context, msg string, attrs ...slog.Attr) {
 	l.logWithLevel(ctx, slog.LevelError, msg, attrs...)
 }
 
@@ -0,0 +1,63 @@
+package clogg
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+func TestNewContextFromContextRoundTrip(t *testing.T) {
+	logger := newLogger(LoggerConfig{BufferSize: 10, Handler: slog.NewJSONHandler(discard{}, nil)})
+	ctx := NewContext(context.Background(), logger)
+
+	if got := FromContext(ctx); got != logger {
+		t.Fatalf("Expected FromContext to return the logger stored by NewContext")
+	}
+}
+
+func TestFromContext_FallsBackToSingleton(t *testing.T) {
+	GetLogger(LoggerConfig{}) // ensure the singleton exists
+	if got := FromContext(context.Background()); got != globalLogger {
+		t.Fatalf("Expected FromContext to fall back to the singleton when ctx carries no logger")
+	}
+}
+
+func TestPackageLevelLogging_UsesContextLogger(t *testing.T) {
+	captured := &captureHandler{}
+	logger := newLogger(LoggerConfig{BufferSize: 10, Handler: captured})
+	ctx := NewContext(context.Background(), logger)
+
+	Info(ctx, "via context logger")
+	logger.Shutdown()
+
+	if got := captured.Count(); got != 1 {
+		t.Fatalf("Expected the context-bound logger to receive the record, got %d", got)
+	}
+}
+
+func TestLogger_ContextExtractorInjectsAttrs(t *testing.T) {
+	extractor := func(ctx context.Context) []Attr {
+		if v, ok := ctx.Value(traceIDKey{}).(string); ok {
+			return []Attr{String("trace_id", v)}
+		}
+		return nil
+	}
+	// newUnstartedLogger: this test inspects logChan directly, so nothing may drain it
+	// in the background.
+	logger := newUnstartedLogger(10, ModeNonBlocking, slog.LevelInfo)
+	logger.extractor = extractor
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "t-1")
+
+	logger.Info(ctx, "traced", String("call", "c"))
+
+	select {
+	case msg := <-logger.logChan:
+		if len(msg.Attrs) != 2 || msg.Attrs[0].Key != "trace_id" || msg.Attrs[1].Key != "call" {
+			t.Fatalf("Expected extractor attrs before call attrs, got %+v", msg.Attrs)
+		}
+	default:
+		t.Fatal("Expected a record in logChan")
+	}
+}
+
+type traceIDKey struct{}
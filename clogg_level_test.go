@@ -0,0 +1,37 @@
+package clogg
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+func TestLogger_LevelAdmissionFilter(t *testing.T) {
+	// newUnstartedLogger: this test inspects logChan directly, so nothing may drain it
+	// in the background.
+	logger := newUnstartedLogger(10, ModeNonBlocking, slog.LevelInfo)
+	ctx := context.Background()
+
+	// Default level is Info: a Debug record should never reach logChan.
+	logger.Debug(ctx, "below threshold")
+	select {
+	case msg := <-logger.logChan:
+		t.Fatalf("Expected Debug record to be filtered out, got %q", msg.Msg)
+	default:
+	}
+
+	logger.SetLevel(slog.LevelDebug)
+	if got := logger.Level(); got != slog.LevelDebug {
+		t.Fatalf("Expected Level() to report LevelDebug, got %v", got)
+	}
+
+	logger.Debug(ctx, "now admitted")
+	select {
+	case msg := <-logger.logChan:
+		if msg.Msg != "now admitted" {
+			t.Fatalf("Expected 'now admitted', got %q", msg.Msg)
+		}
+	default:
+		t.Fatal("Expected the Debug record to be admitted after SetLevel")
+	}
+}
@@ -0,0 +1,79 @@
+package clogg
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestLogger_ModeDropNewest(t *testing.T) {
+	logger := newUnstartedLogger(1, ModeDropNewest, slog.LevelDebug)
+	ctx := context.Background()
+
+	logger.Debug(ctx, "first")
+	logger.Debug(ctx, "second") // logChan is full; should be dropped immediately
+
+	if stats := logger.Stats(); stats.Enqueued != 1 || stats.Dropped != 1 {
+		t.Fatalf("Expected Enqueued=1, Dropped=1, got %+v", stats)
+	}
+
+	select {
+	case msg := <-logger.logChan:
+		if msg.Msg != "first" {
+			t.Fatalf("Expected 'first' to survive, got %q", msg.Msg)
+		}
+	default:
+		t.Fatal("Expected a message in logChan")
+	}
+}
+
+func TestLogger_ModeDropOldest(t *testing.T) {
+	logger := newUnstartedLogger(1, ModeDropOldest, slog.LevelDebug)
+	ctx := context.Background()
+
+	logger.Debug(ctx, "first")
+	logger.Debug(ctx, "second") // should evict "first" and enqueue "second" instead
+
+	if stats := logger.Stats(); stats.Enqueued != 2 || stats.Dropped != 1 {
+		t.Fatalf("Expected Enqueued=2, Dropped=1, got %+v", stats)
+	}
+
+	select {
+	case msg := <-logger.logChan:
+		if msg.Msg != "second" {
+			t.Fatalf("Expected the newest message to survive, got %q", msg.Msg)
+		}
+	default:
+		t.Fatal("Expected a message in logChan")
+	}
+}
+
+func TestLogger_ModeBlocking(t *testing.T) {
+	// No processLogs goroutine is running (newUnstartedLogger), so logChan only empties
+	// when this test reads from it directly below - the send below is guaranteed to block.
+	logger := newUnstartedLogger(1, ModeBlocking, slog.LevelDebug)
+	ctx := context.Background()
+
+	logger.Debug(ctx, "first")
+
+	done := make(chan struct{})
+	go func() {
+		logger.Debug(ctx, "second") // must block until "first" is drained
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("ModeBlocking send returned before logChan had room")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	<-logger.logChan // drain "first", unblocking the goroutine above
+
+	select {
+	case <-done:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("ModeBlocking send never unblocked after logChan had room")
+	}
+}
@@ -0,0 +1,98 @@
+package clogg
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestSampleTable_CollidingKeysEvictEachOther(t *testing.T) {
+	var table sampleTable[int]
+
+	var reset1, reset2, reset3, reset4 bool
+	table.withEntry(1, func(e *int, reset bool) { *e = 100; reset1 = reset })
+	if !reset1 {
+		t.Fatal("Expected the first write to key 1 to report a reset")
+	}
+
+	table.withEntry(1, func(e *int, reset bool) {
+		reset2 = reset
+		if *e != 100 {
+			t.Fatalf("Expected revisiting key 1 to see its prior value, got %d", *e)
+		}
+	})
+	if reset2 {
+		t.Fatal("Expected revisiting the same key not to reset")
+	}
+
+	// 1025 shares key 1's shard and slot (1025 % 16 == 1, (1025/16) % 64 == 0), so it evicts it.
+	table.withEntry(1025, func(e *int, reset bool) { *e = 200; reset3 = reset })
+	if !reset3 {
+		t.Fatal("Expected key 1025 to evict key 1's slot and report a reset")
+	}
+
+	table.withEntry(1, func(e *int, reset bool) { reset4 = reset })
+	if !reset4 {
+		t.Fatal("Expected key 1 to have been evicted by key 1025")
+	}
+}
+
+func TestTokenBucketSampler_BurstThenRefill(t *testing.T) {
+	sampler := &TokenBucketSampler{Tier: map[slog.Level]Rate{
+		slog.LevelInfo: {Burst: 1, Interval: 20 * time.Millisecond},
+	}}
+
+	if d := sampler.Sample(slog.LevelInfo, "x"); !d.Admit {
+		t.Fatal("Expected the first record to be admitted by the initial burst")
+	}
+	if d := sampler.Sample(slog.LevelInfo, "x"); d.Admit {
+		t.Fatal("Expected the second record to be rejected once the burst is spent")
+	}
+	if d := sampler.Sample(slog.LevelInfo, "x"); d.Admit {
+		t.Fatal("Expected the third record to also be rejected")
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	d := sampler.Sample(slog.LevelInfo, "x")
+	if !d.Admit {
+		t.Fatal("Expected a record to be admitted once the bucket refills")
+	}
+	if d.SampledCount != 2 {
+		t.Fatalf("Expected SampledCount to report the 2 suppressed records, got %d", d.SampledCount)
+	}
+}
+
+func TestTokenBucketSampler_LevelWithoutTierAdmitsUnconditionally(t *testing.T) {
+	sampler := &TokenBucketSampler{Tier: map[slog.Level]Rate{
+		slog.LevelInfo: {Burst: 1, Interval: time.Hour},
+	}}
+
+	for i := 0; i < 5; i++ {
+		if d := sampler.Sample(slog.LevelDebug, "y"); !d.Admit {
+			t.Fatal("Expected a level absent from Tier to always be admitted")
+		}
+	}
+}
+
+func TestFirstNThenEveryM_AdmitSequence(t *testing.T) {
+	sampler := &FirstNThenEveryM{N: 2, M: 3, Interval: time.Hour}
+
+	want := []bool{true, true, true, false, false, true}
+	var sixth SampleDecision
+	for i, admit := range want {
+		d := sampler.Sample(slog.LevelWarn, "z")
+		if d.Admit != admit {
+			t.Fatalf("call %d: expected Admit=%v, got %v", i+1, admit, d.Admit)
+		}
+		if i == 5 {
+			sixth = d
+		}
+	}
+	if sixth.SampledCount != 2 {
+		t.Fatalf("Expected the 6th call to report the 2 rejected calls before it, got %d", sixth.SampledCount)
+	}
+
+	if d := sampler.Sample(slog.LevelWarn, "z"); d.Admit {
+		t.Fatal("Expected the 7th call to be rejected (not <= N and not a multiple of M)")
+	}
+}
@@ -0,0 +1,117 @@
+package clogg
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// captureHandler is a slog.Handler that records how many records it received, for
+// asserting per-sink delivery without depending on output formatting.
+type captureHandler struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *captureHandler) Handle(context.Context, slog.Record) error {
+	h.mu.Lock()
+	h.count++
+	h.mu.Unlock()
+	return nil
+}
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+func (h *captureHandler) Count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+func TestLogger_SinkFanOutPerSinkLevel(t *testing.T) {
+	infoSink := &captureHandler{}
+	errSink := &captureHandler{}
+	logger := newLogger(LoggerConfig{
+		BufferSize: 10,
+		Sinks: []Sink{
+			{Handler: infoSink, MinLevel: slog.LevelInfo, Name: "info"},
+			{Handler: errSink, MinLevel: slog.LevelError, Name: "error"},
+		},
+	})
+
+	ctx := context.Background()
+	logger.Info(ctx, "info message")
+	logger.Shutdown()
+
+	if got := infoSink.Count(); got != 1 {
+		t.Fatalf("Expected info sink to receive 1 record, got %d", got)
+	}
+	if got := errSink.Count(); got != 0 {
+		t.Fatalf("Expected error sink to receive 0 records, got %d", got)
+	}
+}
+
+func TestLogger_SinkFanOutAdmitsAboveMinLevel(t *testing.T) {
+	infoSink := &captureHandler{}
+	errSink := &captureHandler{}
+	logger := newLogger(LoggerConfig{
+		BufferSize: 10,
+		Sinks: []Sink{
+			{Handler: infoSink, MinLevel: slog.LevelInfo, Name: "info"},
+			{Handler: errSink, MinLevel: slog.LevelError, Name: "error"},
+		},
+	})
+
+	ctx := context.Background()
+	logger.Error(ctx, "error message")
+	logger.Shutdown()
+
+	if got := infoSink.Count(); got != 1 {
+		t.Fatalf("Expected info sink to also receive the error record, got %d", got)
+	}
+	if got := errSink.Count(); got != 1 {
+		t.Fatalf("Expected error sink to receive 1 record, got %d", got)
+	}
+}
+
+// blockingHandler blocks every Handle call until release is closed, simulating a sink
+// that is stuck (e.g. a slow network write).
+type blockingHandler struct {
+	release chan struct{}
+}
+
+func (h *blockingHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *blockingHandler) Handle(context.Context, slog.Record) error {
+	<-h.release
+	return nil
+}
+func (h *blockingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *blockingHandler) WithGroup(string) slog.Handler      { return h }
+
+func TestSinkWorker_DispatchNeverBlocks(t *testing.T) {
+	release := make(chan struct{})
+	w := newSinkWorker(Sink{Handler: &blockingHandler{release: release}, MinLevel: slog.LevelDebug, Name: "slow"}, 1)
+
+	msg := log{Level: slog.LevelInfo, Msg: "m", Ctx: context.Background()}
+
+	w.dispatch(msg) // buffered send; run() picks it up and blocks in Handle
+	time.Sleep(10 * time.Millisecond)
+	w.dispatch(msg) // inbox is empty again; buffered send succeeds
+
+	start := time.Now()
+	w.dispatch(msg) // inbox is full now; must drop rather than block
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("dispatch blocked for %v on a full inbox", elapsed)
+	}
+
+	close(release)
+	close(w.inbox)
+	<-w.done
+
+	if got := w.dropped.Load(); got != 1 {
+		t.Fatalf("Expected 1 dropped message, got %d", got)
+	}
+}
@@ -5,10 +5,37 @@ import (
 	"log/slog"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
 
+// newUnstartedLogger builds a Logger for white-box tests that inspect or fill logChan
+// directly. Unlike newLogger, it never starts processLogs, so nothing races the test's own
+// reads and writes on logChan.
+func newUnstartedLogger(bufferSize int, mode Mode, level slog.Level) *Logger {
+	lv := &slog.LevelVar{}
+	lv.Set(level)
+	l := &Logger{
+		logChan:       make(chan log, bufferSize),
+		mode:          mode,
+		retryAttempts: defaultRetryAttempts,
+		retryBackoff:  defaultRetryBackoff,
+		enqueued:      &atomic.Uint64{},
+		dropped:       &atomic.Uint64{},
+		blocked:       &atomic.Uint64{},
+		level:         lv,
+		sampleStats:   &sync.Map{},
+	}
+	l.root = l
+	return l
+}
+
+// discard is an io.Writer that throws away everything written to it.
+type discard struct{}
+
+func (discard) Write(p []byte) (int, error) { return len(p), nil }
+
 func TestGetLogger_DefaultConfig(t *testing.T) {
 	logger := GetLogger(LoggerConfig{})
 	if logger == nil {
@@ -21,7 +48,9 @@ func TestGetLogger_DefaultConfig(t *testing.T) {
 
 func TestGetLogger_CustomConfig(t *testing.T) {
 	handler := slog.NewJSONHandler(os.Stdout, nil)
-	logger := GetLogger(LoggerConfig{BufferSize: 50, Handler: handler})
+	// newLogger, which GetLogger calls: the shared singleton may already be initialized by
+	// an earlier test, and GetLogger would then ignore this config.
+	logger := newLogger(LoggerConfig{BufferSize: 50, Handler: handler})
 	if logger == nil {
 		t.Fatal("Expected logger to be initialized, got nil")
 	}
@@ -51,9 +80,11 @@ func TestLogger_Singleton(t *testing.T) {
 }
 
 func TestLogger_Debug(t *testing.T) {
-	logger := GetLogger(LoggerConfig{})
+	// newUnstartedLogger, not the shared GetLogger singleton: this test inspects logChan
+	// directly, which would otherwise race the singleton's long-running processLogs.
+	logger := newUnstartedLogger(10, ModeNonBlocking, slog.LevelDebug)
 	ctx := context.Background()
-	logger.debug(ctx, "debug message", String("key", "value"))
+	logger.Debug(ctx, "debug message", String("key", "value"))
 
 	select {
 	case msg := <-logger.logChan:
@@ -69,15 +100,17 @@ func TestLogger_Debug(t *testing.T) {
 }
 
 func TestLoggerShutdown(t *testing.T) {
-	// Create a logger with a small buffer size for testing
-	logger := GetLogger(LoggerConfig{
+	// Create a standalone logger so shutting it down doesn't affect other tests
+	// sharing the package singleton.
+	logger := newLogger(LoggerConfig{
 		BufferSize: 2,
 		Handler:    nil, // Replace with a mock handler if needed
 	})
+	logger.SetLevel(slog.LevelDebug)
 
 	// Log some messages
-	Debug(context.Background(), "Test message 1")
-	Debug(context.Background(), "Test message 2")
+	logger.Debug(context.Background(), "Test message 1")
+	logger.Debug(context.Background(), "Test message 2")
 
 	// Call Shutdown
 	logger.Shutdown()
@@ -92,14 +125,13 @@ func TestLoggerShutdown(t *testing.T) {
 }
 
 func TestLogger_BufferFull(t *testing.T) {
-	handler := slog.NewJSONHandler(os.Stdout, nil)
-	logger := GetLogger(LoggerConfig{BufferSize: 1, Handler: handler})
+	// newUnstartedLogger: nothing drains logChan in the background, so "first message"
+	// is guaranteed to still be there once the retries below give up on "second message".
+	logger := newUnstartedLogger(1, ModeNonBlocking, slog.LevelDebug)
 	ctx := context.Background()
 
-	logger.debug(ctx, "first message")
-	logger.debug(ctx, "second message") // This should trigger retry logic
-
-	time.Sleep(50 * time.Millisecond) // Allow retries to occur
+	logger.Debug(ctx, "first message")
+	logger.Debug(ctx, "second message") // logChan is full; retries and then drops
 
 	select {
 	case msg := <-logger.logChan:
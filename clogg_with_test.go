@@ -0,0 +1,77 @@
+package clogg
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+func TestLogger_WithBindsAttrsToChildOnly(t *testing.T) {
+	// newUnstartedLogger: these tests inspect logChan directly, so nothing may drain it
+	// in the background.
+	logger := newUnstartedLogger(10, ModeNonBlocking, slog.LevelInfo)
+	child := logger.With(String("request_id", "abc"))
+	ctx := context.Background()
+
+	child.Info(ctx, "from child")
+	select {
+	case msg := <-logger.logChan:
+		if len(msg.Attrs) != 1 || msg.Attrs[0].Key != "request_id" {
+			t.Fatalf("Expected child record to carry request_id, got %+v", msg.Attrs)
+		}
+	default:
+		t.Fatal("Expected a record in logChan")
+	}
+
+	logger.Info(ctx, "from parent")
+	select {
+	case msg := <-logger.logChan:
+		if len(msg.Attrs) != 0 {
+			t.Fatalf("Expected parent record to carry no attrs, got %+v", msg.Attrs)
+		}
+	default:
+		t.Fatal("Expected a record in logChan")
+	}
+}
+
+func TestLogger_WithGroupNestsAttrsInOrder(t *testing.T) {
+	logger := newUnstartedLogger(10, ModeNonBlocking, slog.LevelInfo)
+	child := logger.With(String("outer", "o")).WithGroup("req").With(String("inner", "i"))
+	ctx := context.Background()
+
+	child.Info(ctx, "nested", String("call", "c"))
+	select {
+	case msg := <-logger.logChan:
+		if len(msg.Attrs) != 2 {
+			t.Fatalf("Expected 2 top-level attrs (outer, req group), got %d: %+v", len(msg.Attrs), msg.Attrs)
+		}
+		if msg.Attrs[0].Key != "outer" {
+			t.Fatalf("Expected first attr to be 'outer', got %q", msg.Attrs[0].Key)
+		}
+		group := msg.Attrs[1]
+		if group.Key != "req" || group.Value.Kind() != slog.KindGroup {
+			t.Fatalf("Expected second attr to be the 'req' group, got %+v", group)
+		}
+		inner := group.Value.Group()
+		if len(inner) != 2 || inner[0].Key != "inner" || inner[1].Key != "call" {
+			t.Fatalf("Expected group to contain inner then call, got %+v", inner)
+		}
+	default:
+		t.Fatal("Expected a record in logChan")
+	}
+}
+
+func TestLogger_ShutdownOnChildIsNoop(t *testing.T) {
+	logger := newLogger(LoggerConfig{BufferSize: 10, Handler: slog.NewJSONHandler(discard{}, nil)})
+	child := logger.With(String("k", "v"))
+
+	child.Shutdown()
+
+	select {
+	case <-logger.done:
+		t.Fatal("Expected root logger to still be running after child.Shutdown()")
+	default:
+	}
+
+	logger.Shutdown()
+}